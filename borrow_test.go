@@ -0,0 +1,73 @@
+package splash
+
+import (
+	"testing"
+	"time"
+)
+
+func testPool(t *testing.T, options ...Option[int]) *Pool[int] {
+	t.Helper()
+
+	next := 0
+	opts := append([]Option[int]{
+		Minimum[int](0),
+		Allocator(func() (int, error) {
+			next++
+			return next, nil
+		}),
+		Deallocator(func(int) error {
+			return nil
+		}),
+	}, options...)
+
+	p, err := NewPool(10, opts...)
+	if err != nil {
+		t.Fatalf("NewPool: %v", err)
+	}
+	return p
+}
+
+func TestBorrowReleaseAndErrNotBorrowed(t *testing.T) {
+	p := testPool(t)
+
+	p.Borrow()
+	if err := p.Release(); err != nil {
+		t.Fatalf("Release after Borrow: %v", err)
+	}
+	if err := p.Release(); err != ErrNotBorrowed {
+		t.Fatalf("Release without a matching Borrow: got %v, want ErrNotBorrowed", err)
+	}
+}
+
+func TestMaxInUseTryBorrow(t *testing.T) {
+	p := testPool(t, MaxInUse[int](2))
+
+	if !p.TryBorrow() {
+		t.Fatalf("first TryBorrow should succeed")
+	}
+	if !p.TryBorrow() {
+		t.Fatalf("second TryBorrow should succeed")
+	}
+	if p.TryBorrow() {
+		t.Fatalf("third TryBorrow should fail, MaxInUse is 2")
+	}
+
+	if err := p.Release(); err != nil {
+		t.Fatalf("Release: %v", err)
+	}
+	if !p.TryBorrow() {
+		t.Fatalf("TryBorrow should succeed again after a Release")
+	}
+}
+
+func waitFor(t *testing.T, timeout time.Duration, cond func() bool) {
+	t.Helper()
+
+	deadline := time.Now().Add(timeout)
+	for !cond() {
+		if time.Now().After(deadline) {
+			t.Fatalf("condition not met within %s", timeout)
+		}
+		time.Sleep(2 * time.Millisecond)
+	}
+}
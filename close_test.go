@@ -0,0 +1,115 @@
+package splash
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCloseWaitsForOutstanding(t *testing.T) {
+	p := testPool(t, Minimum[int](1))
+	waitFor(t, time.Second, func() bool { return p.GetAvailable() > 0 })
+
+	got, err := p.FetchContext(context.Background())
+	if err != nil {
+		t.Fatalf("FetchContext: %v", err)
+	}
+
+	done := make(chan error, 1)
+	go func() {
+		done <- p.Close(context.Background())
+	}()
+
+	select {
+	case <-done:
+		t.Fatalf("Close returned before the outstanding resource was returned")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	p.Return(got)
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("Close: %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatalf("Close did not return within 2s of the outstanding resource being returned")
+	}
+
+	if _, err := p.FetchContext(context.Background()); !errors.Is(err, ErrPoolClosed) {
+		t.Fatalf("FetchContext after Close: got %v, want ErrPoolClosed", err)
+	}
+}
+
+func TestCloseContextDeadline(t *testing.T) {
+	p := testPool(t, Minimum[int](1))
+	waitFor(t, time.Second, func() bool { return p.GetAvailable() > 0 })
+
+	if _, err := p.FetchContext(context.Background()); err != nil {
+		t.Fatalf("FetchContext: %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	if err := p.Close(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("Close: got %v, want context.DeadlineExceeded", err)
+	}
+}
+
+func TestCloseDoesNotLeakInFlightReplenish(t *testing.T) {
+	var allocs int64
+	slow := make(chan struct{})
+
+	p, err := NewPool(10,
+		Minimum[int](1),
+		MaxIdle[int](10*time.Millisecond),
+		Allocator(func() (int, error) {
+			n := atomic.AddInt64(&allocs, 1)
+			if n == 2 {
+				<-slow
+			}
+			return int(n), nil
+		}),
+		Deallocator(func(int) error { return nil }),
+	)
+	if err != nil {
+		t.Fatalf("NewPool: %v", err)
+	}
+
+	// Wait for the sole idle resource to age out via MaxIdle and for the
+	// monitor's replenish allocation (the slow, second call) to start.
+	waitFor(t, time.Second, func() bool { return atomic.LoadInt64(&allocs) >= 2 })
+
+	done := make(chan error, 1)
+	go func() {
+		done <- p.Close(context.Background())
+	}()
+
+	select {
+	case <-done:
+		t.Fatalf("Close returned while a replenish allocation was still in flight")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	close(slow)
+
+	select {
+	case err := <-done:
+		if err != nil {
+			t.Fatalf("Close: %v", err)
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatalf("Close did not return within 2s of the in-flight replenish unblocking")
+	}
+
+	if s := p.Stats(); s.TotalDeallocated != s.TotalAllocated {
+		t.Fatalf("TotalAllocated=%d TotalDeallocated=%d, want equal: the in-flight replenish leaked instead of being drained", s.TotalAllocated, s.TotalDeallocated)
+	}
+	if p.GetAvailable() != 0 {
+		t.Fatalf("GetAvailable() = %d, want 0 after Close", p.GetAvailable())
+	}
+}
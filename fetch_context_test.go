@@ -0,0 +1,71 @@
+package splash
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestFetchContextBlocksUntilResourceAvailable(t *testing.T) {
+	p := testPool(t)
+
+	result := make(chan int, 1)
+	errCh := make(chan error, 1)
+	go func() {
+		got, err := p.FetchContext(context.Background())
+		if err != nil {
+			errCh <- err
+			return
+		}
+		result <- got
+	}()
+
+	select {
+	case <-result:
+		t.Fatalf("FetchContext returned before any resource was available")
+	case <-errCh:
+		t.Fatalf("FetchContext errored before any resource was available")
+	case <-time.After(100 * time.Millisecond):
+	}
+
+	p.Return(42)
+
+	select {
+	case got := <-result:
+		if got != 42 {
+			t.Fatalf("FetchContext returned %d, want 42", got)
+		}
+	case err := <-errCh:
+		t.Fatalf("FetchContext: %v", err)
+	case <-time.After(2 * time.Second):
+		t.Fatalf("FetchContext did not unblock within 2s of a resource becoming available")
+	}
+}
+
+func TestFetchContextCancellationDistinctFromTimeout(t *testing.T) {
+	p := testPool(t)
+
+	ctx, cancel := context.WithCancel(context.Background())
+
+	errCh := make(chan error, 1)
+	go func() {
+		_, err := p.FetchContext(ctx)
+		errCh <- err
+	}()
+
+	time.Sleep(20 * time.Millisecond)
+	cancel()
+
+	select {
+	case err := <-errCh:
+		if !errors.Is(err, context.Canceled) {
+			t.Fatalf("FetchContext after cancel: got %v, want context.Canceled", err)
+		}
+		if errors.Is(err, ErrPoolExhausted) {
+			t.Fatalf("FetchContext after cancel returned ErrPoolExhausted, want context.Canceled")
+		}
+	case <-time.After(2 * time.Second):
+		t.Fatalf("FetchContext did not return within 2s of cancellation")
+	}
+}
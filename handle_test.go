@@ -0,0 +1,35 @@
+package splash
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestFetchHandleReturnAndMarkAsInvalid(t *testing.T) {
+	p := testPool(t, Minimum[int](1))
+	waitFor(t, time.Second, func() bool { return p.GetAvailable() > 0 })
+
+	h, err := p.FetchHandleContext(context.Background())
+	if err != nil {
+		t.Fatalf("FetchHandleContext: %v", err)
+	}
+	resource := h.Resource()
+	h.Return()
+
+	if p.GetAvailable() != 1 {
+		t.Fatalf("GetAvailable() = %d, want 1 after Return", p.GetAvailable())
+	}
+
+	h2, err := p.FetchHandleContext(context.Background())
+	if err != nil {
+		t.Fatalf("FetchHandleContext: %v", err)
+	}
+	if h2.Resource() != resource {
+		t.Fatalf("FetchHandleContext returned %d, want the same resource %d back", h2.Resource(), resource)
+	}
+
+	before := p.Stats().TotalDeallocated
+	h2.MarkAsInvalid()
+	waitFor(t, time.Second, func() bool { return p.Stats().TotalDeallocated > before })
+}
@@ -0,0 +1,28 @@
+package splash
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestMaxLifetimeSurvivesBusyFetchReturnViaHandle(t *testing.T) {
+	p := testPool(t, Minimum[int](1), MaxLifetime[int](30*time.Millisecond))
+	waitFor(t, time.Second, func() bool { return p.Stats().TotalAllocated == 1 })
+
+	seen := map[int]bool{}
+	deadline := time.Now().Add(200 * time.Millisecond)
+	for time.Now().Before(deadline) {
+		h, err := p.FetchHandleContext(context.Background())
+		if err != nil {
+			t.Fatalf("FetchHandleContext: %v", err)
+		}
+		seen[h.Resource()] = true
+		time.Sleep(5 * time.Millisecond)
+		h.Return()
+	}
+
+	if len(seen) < 2 {
+		t.Fatalf("saw %d distinct resources over 200ms with a 30ms MaxLifetime, want at least 2 (MaxLifetime should rotate the resource even under a busy Fetch/Return cycle)", len(seen))
+	}
+}
@@ -8,46 +8,273 @@
 package splash
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"sync"
+	"sync/atomic"
 	"time"
 )
 
-// Resource is a resource that you wish to store in a splash Pool.
+// ErrPoolExhausted is returned by FetchContext when no resource became
+// available before the context's deadline expired.
+var ErrPoolExhausted = errors.New("splash: pool exhausted")
+
+// ErrPoolClosed is returned by FetchContext when the pool has been closed
+// and can no longer hand out resources.
+var ErrPoolClosed = errors.New("splash: pool closed")
+
+// ErrNotBorrowed is returned by Release when it is called more times than
+// Borrow (or TryBorrow) succeeded, which would otherwise let more than
+// MaxInUse resources be outstanding at once.
+var ErrNotBorrowed = errors.New("splash: release called without a matching borrow")
+
+// Resource is the empty-interface resource type of the pre-generics API.
+// Code written before Pool became generic can keep using it as
+// Pool[Resource]. New code should use Pool[T] with a concrete resource type
+// instead, which gets compile-time type safety in place of runtime type
+// assertions.
 type Resource interface{}
 
+// EventType identifies the kind of occurrence described by an Event.
+type EventType int
+
+// The event types delivered to an OnEvent hook.
+const (
+	EventAllocated EventType = iota
+	EventAllocError
+	EventDeallocated
+	EventFetched
+	EventReturned
+	EventTimeout
+)
+
+// Event describes a single occurrence within a Pool, delivered to an
+// OnEvent hook so callers can bridge pool activity into their own metrics
+// system (Prometheus, OpenTelemetry, etc.) without splash importing them.
+type Event struct {
+	Type     EventType
+	Resource Resource
+	Err      error
+}
+
+// Stats is a snapshot of Pool activity, modeled on database/sql.DBStats.
+type Stats struct {
+	Idle             int
+	InUse            int64
+	TotalAllocated   int64
+	TotalDeallocated int64
+	AllocErrors      int64
+	WaitCount        int64
+	WaitDuration     time.Duration
+	Timeouts         int64
+}
+
+// Option configures a Pool[T] at construction time via NewPool.
+type Option[T any] func(*Pool[T]) error
+
+// pooledItem wraps a resource with the bookkeeping needed to validate and
+// expire it: when it was allocated, and when it was last returned to the
+// idle set.
+type pooledItem[T any] struct {
+	resource     T
+	createdAt    time.Time
+	lastReturned time.Time
+}
+
 // Pool contains the structures representing the pool of things that
 // you wish to share.
-type Pool struct {
-	resources         chan Resource
+//
+// Pool is generic over the resource type T. Go does not allow a generic
+// type and a plain type alias to share one name, so there is no bare
+// "Pool" left for the pre-generics interface{}-based API to keep
+// resolving to: code written against that API needs the one-line
+// mechanical change from *splash.Pool to *splash.Pool[splash.Resource]
+// (and the matching change to NewPool's call).
+type Pool[T any] struct {
+	resources         chan *pooledItem[T]
 	minimum           int
-	timeout           int
-	allocator         func() (Resource, error)
-	deallocator       func(Resource) error
+	timeout           time.Duration
+	maxInUse          int
+	inUseSem          chan struct{}
+	validator         func(T) bool
+	maxIdle           time.Duration
+	maxLifetime       time.Duration
+	liveCount         int64
+	allocator         func() (T, error)
+	deallocator       func(T) error
 	logErrorHandler   func(error)
 	logMessageHandler func(string)
 	quitCommand       chan bool
+	monitorDone       chan struct{}
+	closeMu           sync.Mutex
+	closed            bool
+	onEvent           func(Event)
+	inUse             int64
+	totalAllocated    int64
+	totalDeallocated  int64
+	allocErrors       int64
+	waitCount         int64
+	waitDuration      int64
+	timeouts          int64
 }
 
-func (p *Pool) setMinimum(m int) error {
+func (p *Pool[T]) setMinimum(m int) error {
 	p.minimum = m
 	return nil
 }
 
-// Minimum sets the minimum number of
-func Minimum(m int) func(p *Pool) error {
-	return func(p *Pool) error {
+// Minimum sets the minimum number of idle resources the pool tries to
+// maintain. Since m carries no information about T, it cannot be inferred
+// from this call alone; when Minimum is the only option that would
+// otherwise fix a NewPool call's resource type, instantiate it explicitly,
+// e.g. Minimum[MyResource](5).
+func Minimum[T any](m int) Option[T] {
+	return func(p *Pool[T]) error {
 		return p.setMinimum(m)
 	}
 }
 
-// NewPool allocates a new Pool with a given capacity.
-func NewPool(capacity int, options ...func(*Pool) error) (*Pool, error) {
+func (p *Pool[T]) setTimeout(d time.Duration) error {
+	p.timeout = d
+	return nil
+}
+
+// Timeout sets the default duration that FetchContext will wait for a
+// resource to become available when the caller's context has no deadline
+// of its own. A value of 0 (the default) means FetchContext will wait
+// forever unless the context is cancelled. See Minimum for a note on
+// explicit type instantiation.
+func Timeout[T any](d time.Duration) Option[T] {
+	return func(p *Pool[T]) error {
+		return p.setTimeout(d)
+	}
+}
+
+func (p *Pool[T]) setMaxInUse(n int) error {
+	if n < 1 {
+		return fmt.Errorf("MaxInUse must be at least 1")
+	}
+	p.maxInUse = n
+	return nil
+}
+
+// MaxInUse caps the number of resources that may be checked out via Borrow
+// at any one time, independently of the pool's idle capacity. Callers that
+// need a hard concurrency limit should pair MaxInUse with Borrow/Release
+// instead of (or as well as) Fetch/Return. See Minimum for a note on
+// explicit type instantiation.
+func MaxInUse[T any](n int) Option[T] {
+	return func(p *Pool[T]) error {
+		return p.setMaxInUse(n)
+	}
+}
+
+func (p *Pool[T]) setValidator(v func(T) bool) error {
+	p.validator = v
+	return nil
+}
+
+// Validator installs a health check that Fetch/FetchContext runs against an
+// idle resource before handing it to a caller. A resource that fails the
+// check is deallocated instead of being returned, and Fetch tries the next
+// one.
+func Validator[T any](v func(T) bool) Option[T] {
+	return func(p *Pool[T]) error {
+		return p.setValidator(v)
+	}
+}
+
+func (p *Pool[T]) setMaxIdle(d time.Duration) error {
+	p.maxIdle = d
+	return nil
+}
+
+// MaxIdle sets the maximum amount of time a resource may sit unused in the
+// pool before the monitor goroutine deallocates it. A value of 0 (the
+// default) means idle resources are never expired. See Minimum for a note
+// on explicit type instantiation.
+func MaxIdle[T any](d time.Duration) Option[T] {
+	return func(p *Pool[T]) error {
+		return p.setMaxIdle(d)
+	}
+}
+
+func (p *Pool[T]) setMaxLifetime(d time.Duration) error {
+	p.maxLifetime = d
+	return nil
+}
+
+// MaxLifetime sets the maximum amount of time a resource may exist before
+// the pool deallocates it instead of handing it out again. A value of 0
+// (the default) means resources never expire due to age alone. See Minimum
+// for a note on explicit type instantiation.
+func MaxLifetime[T any](d time.Duration) Option[T] {
+	return func(p *Pool[T]) error {
+		return p.setMaxLifetime(d)
+	}
+}
+
+func (p *Pool[T]) setAllocator(a func() (T, error)) error {
+	p.allocator = a
+	return nil
+}
+
+// Allocator sets the function the pool uses to create new resources. It is
+// required: NewPool returns an error if no Allocator option is given.
+func Allocator[T any](a func() (T, error)) Option[T] {
+	return func(p *Pool[T]) error {
+		return p.setAllocator(a)
+	}
+}
+
+func (p *Pool[T]) setDeallocator(d func(T) error) error {
+	p.deallocator = d
+	return nil
+}
+
+// Deallocator sets the function the pool uses to dispose of a resource that
+// is being discarded rather than returned to the idle set. It is required:
+// NewPool returns an error if no Deallocator option is given.
+func Deallocator[T any](d func(T) error) Option[T] {
+	return func(p *Pool[T]) error {
+		return p.setDeallocator(d)
+	}
+}
+
+func (p *Pool[T]) setOnEvent(f func(Event)) error {
+	p.onEvent = f
+	return nil
+}
+
+// OnEvent installs a hook that is called for every notable occurrence
+// within the pool (allocation, deallocation, fetch, return, timeout). Use
+// it to bridge splash into whatever observability stack the caller uses.
+// See Minimum for a note on explicit type instantiation.
+func OnEvent[T any](f func(Event)) Option[T] {
+	return func(p *Pool[T]) error {
+		return p.setOnEvent(f)
+	}
+}
+
+// emit delivers an Event to the OnEvent hook, if one was configured.
+func (p *Pool[T]) emit(e Event) {
+	if p.onEvent != nil {
+		p.onEvent(e)
+	}
+}
+
+// NewPool allocates a new Pool with a given capacity. The resource type T
+// is usually inferred automatically from the required Allocator/Deallocator
+// options; see Minimum for the cases where an option must be instantiated
+// explicitly.
+func NewPool[T any](capacity int, options ...Option[T]) (*Pool[T], error) {
 	if capacity < 10 {
 		return nil, fmt.Errorf("a pool must have a capacity of at least 10")
 	}
 
-	p := new(Pool)
-	p.resources = make(chan Resource, capacity)
+	p := new(Pool[T])
+	p.resources = make(chan *pooledItem[T], capacity)
 	p.minimum = capacity / 10
 	p.logErrorHandler = func(e error) {
 		fmt.Printf("splash ERROR: %v\n", e.Error())
@@ -56,6 +283,7 @@ func NewPool(capacity int, options ...func(*Pool) error) (*Pool, error) {
 		fmt.Printf("splash INFO: %v\n", m)
 	}
 	p.quitCommand = make(chan bool)
+	p.monitorDone = make(chan struct{})
 
 	for _, option := range options {
 		err := option(p)
@@ -64,33 +292,49 @@ func NewPool(capacity int, options ...func(*Pool) error) (*Pool, error) {
 		}
 	}
 
+	if p.allocator == nil {
+		return nil, fmt.Errorf("a pool must be given an Allocator option")
+	}
+	if p.deallocator == nil {
+		return nil, fmt.Errorf("a pool must be given a Deallocator option")
+	}
+
+	if p.maxInUse == 0 {
+		p.maxInUse = capacity
+	}
+	p.inUseSem = make(chan struct{}, p.maxInUse)
+
 	// Create initial set of resources
 	for i := 0; i < p.minimum; i++ {
-		r, err := p.allocator()
-		if err == nil {
-			p.logMessageHandler("resource allocated ")
-		} else {
+		item, err := p.allocate()
+		if err != nil {
 			p.logErrorHandler(fmt.Errorf("unable to initialize pool with allocator: %s", err.Error()))
+			continue
 		}
-		p.resources <- r
+		p.logMessageHandler("resource allocated ")
+		p.resources <- item
 	}
 
 	// start the pool monitor goroutine. This routine is responsible for ensuring that
 	go func() {
+		defer close(p.monitorDone)
 		for {
 			select {
 			case <-p.quitCommand:
 				p.logMessageHandler("splash pool monitor exiting")
 				return
 			default:
+				p.sweepIdle()
+
 				if len(p.resources) < p.minimum {
-					r, err := p.allocator()
-					if err == nil {
-						p.logMessageHandler("resource allocated ")
-					} else {
+					item, err := p.allocate()
+					if err != nil {
 						p.logErrorHandler(fmt.Errorf("unable to initialize pool with allocator: %s", err.Error()))
+						time.Sleep(100 * time.Millisecond)
+						continue
 					}
-					p.resources <- r
+					p.logMessageHandler("resource allocated ")
+					p.resources <- item
 				} else {
 					time.Sleep(100 * time.Millisecond)
 				}
@@ -101,42 +345,366 @@ func NewPool(capacity int, options ...func(*Pool) error) (*Pool, error) {
 	return p, nil
 }
 
+// allocate creates a fresh pooledItem via the configured allocator and
+// records its metadata.
+func (p *Pool[T]) allocate() (*pooledItem[T], error) {
+	r, err := p.allocator()
+	if err != nil {
+		atomic.AddInt64(&p.allocErrors, 1)
+		p.emit(Event{Type: EventAllocError, Err: err})
+		return nil, err
+	}
+
+	atomic.AddInt64(&p.totalAllocated, 1)
+	atomic.AddInt64(&p.liveCount, 1)
+	p.emit(Event{Type: EventAllocated, Resource: r})
+
+	now := time.Now()
+	return &pooledItem[T]{resource: r, createdAt: now, lastReturned: now}, nil
+}
+
+// discard deallocates an item and forgets it. The deallocator is run in a
+// goroutine so the caller is never blocked on it.
+func (p *Pool[T]) discard(item *pooledItem[T]) {
+	atomic.AddInt64(&p.totalDeallocated, 1)
+	atomic.AddInt64(&p.liveCount, -1)
+	p.emit(Event{Type: EventDeallocated, Resource: item.resource})
+
+	go func() {
+		if err := p.deallocator(item.resource); err != nil {
+			p.logErrorHandler(fmt.Errorf("unable to deallocate resource: %s", err.Error()))
+		}
+	}()
+}
+
+// expired reports whether an item has failed its health check, or has
+// exceeded MaxLifetime.
+func (p *Pool[T]) expired(item *pooledItem[T]) bool {
+	if p.validator != nil && !p.validator(item.resource) {
+		return true
+	}
+	if p.maxLifetime > 0 && time.Since(item.createdAt) > p.maxLifetime {
+		return true
+	}
+	return false
+}
+
+// sweepIdle drains the idle set, deallocating any item that has exceeded
+// MaxIdle, and returns the rest to the channel. The monitor's usual
+// top-up-to-Minimum step then replaces whatever was swept away.
+func (p *Pool[T]) sweepIdle() {
+	if p.maxIdle <= 0 {
+		return
+	}
+
+	for i := len(p.resources); i > 0; i-- {
+		select {
+		case item := <-p.resources:
+			if time.Since(item.lastReturned) > p.maxIdle {
+				p.discard(item)
+				continue
+			}
+			select {
+			case p.resources <- item:
+			default:
+				p.discard(item)
+			}
+		default:
+			return
+		}
+	}
+}
+
 // Fetch will fetch an item from the pool. You are responsible to return it back to the pool
-// when you are finished with Return(). If the pool is empty, a new item handle will be allocated
-func (p *Pool) Fetch() (interface{}, error) {
-	select {
-	case i := <-p.resources:
-		return i, nil
-	default:
-		// There are 0 items in the pool, so we will allocate one
-		item, err := p.allocator()
-		if err != nil {
-			p.logErrorHandler(fmt.Errorf("unable to create resource with allocator: %s", err.Error()))
+// when you are finished with Return().
+//
+// Deprecated: Fetch gives the caller no way to cancel the wait or set a
+// deadline of its own; only the pool's Timeout, if set, bounds it. Use
+// FetchContext instead.
+func (p *Pool[T]) Fetch() (T, error) {
+	return p.FetchContext(context.Background())
+}
+
+// FetchContext fetches an item from the pool, blocking until one becomes
+// available, the context is cancelled, or the context's deadline (or the
+// pool's default Timeout, if the context has none) is exceeded. You are
+// responsible for returning it back to the pool when you are finished with
+// Return().
+//
+// Like the deprecated Fetch, FetchContext never allocates a new resource
+// on the caller's behalf; it only ever hands back resources that have been
+// placed into the pool by the monitor goroutine or returned by another
+// caller. Idle resources that fail the Validator or have exceeded
+// MaxLifetime are deallocated and skipped rather than handed to the caller.
+// FetchContext returns ErrPoolClosed if the pool has been, or is
+// concurrently being, closed via Close.
+//
+// FetchContext cannot preserve the resource's original allocation time
+// across a subsequent plain Return, so MaxLifetime is only enforced while a
+// resource sits idle between calls. Callers that need MaxLifetime to also
+// cap total lifetime across busy fetch/return cycles should use
+// FetchHandleContext and return the resulting Fetched handle instead.
+func (p *Pool[T]) FetchContext(ctx context.Context) (T, error) {
+	item, err := p.fetchItem(ctx)
+	if err != nil {
+		var zero T
+		return zero, err
+	}
+	return item.resource, nil
+}
+
+// fetchItem is the shared implementation behind FetchContext and
+// FetchHandleContext. It returns the pooledItem itself, rather than just
+// its resource, so FetchHandleContext can hand the item's identity back to
+// Return via a Fetched handle and preserve its original createdAt.
+func (p *Pool[T]) fetchItem(ctx context.Context) (*pooledItem[T], error) {
+	if p.isClosed() {
+		return nil, ErrPoolClosed
+	}
+
+	if _, ok := ctx.Deadline(); !ok && p.timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, p.timeout)
+		defer cancel()
+	}
+
+	start := time.Now()
+	atomic.AddInt64(&p.waitCount, 1)
+	defer func() {
+		atomic.AddInt64(&p.waitDuration, int64(time.Since(start)))
+	}()
+
+	for {
+		select {
+		case item := <-p.resources:
+			if p.expired(item) {
+				p.discard(item)
+				continue
+			}
+			atomic.AddInt64(&p.inUse, 1)
+			p.emit(Event{Type: EventFetched, Resource: item.resource})
+			return item, nil
+		case <-p.quitCommand:
+			return nil, ErrPoolClosed
+		case <-ctx.Done():
+			if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+				atomic.AddInt64(&p.timeouts, 1)
+				p.emit(Event{Type: EventTimeout})
+				return nil, ErrPoolExhausted
+			}
+			return nil, ctx.Err()
 		}
-		return item, nil
 	}
 }
 
 // Return a given item to the pool.
-func (p *Pool) Return(resource interface{}) {
+//
+// Return cannot tell whether resource is the same value it previously
+// handed out via Fetch, because T is not required to be comparable, so it
+// always treats resource as freshly allocated for MaxLifetime purposes. A
+// resource fetched and returned faster than MaxLifetime will therefore
+// never expire through plain Fetch/Return. Use FetchHandle/
+// FetchHandleContext together with the resulting Fetched.Return() instead
+// of Return when MaxLifetime needs to cap total lifetime, not just idle
+// time.
+func (p *Pool[T]) Return(resource T) {
+	now := time.Now()
+	p.returnItem(&pooledItem[T]{resource: resource, createdAt: now, lastReturned: now})
+}
+
+// returnItem returns a pooledItem to the idle set, preserving its
+// createdAt so that MaxLifetime is measured from the resource's original
+// allocation rather than from this Return.
+func (p *Pool[T]) returnItem(item *pooledItem[T]) {
+	atomic.AddInt64(&p.inUse, -1)
+	p.emit(Event{Type: EventReturned, Resource: item.resource})
+	item.lastReturned = time.Now()
+
 	select {
-	case p.resources <- resource:
+	case p.resources <- item:
 		return
 	default:
-		// if we blocked returning the item to the channel, it's full. Just deallocate.
-		// Do it in a goroutine so that we don't block that caller.
-
-		go func() {
-			err := p.deallocator(resource)
-			if err != nil {
-				p.logErrorHandler(fmt.Errorf("unable to return item to pool: %s", err.Error()))
-			}
-		}()
+		// if we blocked returning the item to the channel, it's full. Just discard it.
+		p.discard(item)
 		return
 	}
 }
 
+// Invalidate discards a resource the caller has determined is broken (a
+// dead database handle, a closed socket) instead of returning it to the
+// idle set. Unlike simply not calling Return, it deallocates the resource
+// promptly and lets the monitor goroutine's normal top-up-to-Minimum pass
+// replace it.
+func (p *Pool[T]) Invalidate(resource T) {
+	p.invalidateItem(&pooledItem[T]{resource: resource})
+}
+
+// invalidateItem discards a pooledItem obtained via fetchItem.
+func (p *Pool[T]) invalidateItem(item *pooledItem[T]) {
+	atomic.AddInt64(&p.inUse, -1)
+	p.discard(item)
+}
+
+// Fetched wraps a resource obtained from FetchHandle/FetchHandleContext,
+// for callers that would rather call Return/MarkAsInvalid on the handle
+// than keep track of which Pool a bare resource came from. Unlike a bare
+// resource passed to Pool.Return, a Fetched handle carries the resource's
+// original allocation time, so Fetched.Return() lets MaxLifetime cap total
+// lifetime correctly even under a busy fetch/return cycle.
+type Fetched[T any] struct {
+	pool *Pool[T]
+	item *pooledItem[T]
+}
+
+// Resource returns the wrapped resource.
+func (f Fetched[T]) Resource() T {
+	return f.item.resource
+}
+
+// Return returns the wrapped resource to the pool it was fetched from,
+// preserving its original allocation time for MaxLifetime purposes.
+func (f Fetched[T]) Return() {
+	f.pool.returnItem(f.item)
+}
+
+// MarkAsInvalid discards the wrapped resource instead of returning it to
+// the pool it was fetched from.
+func (f Fetched[T]) MarkAsInvalid() {
+	f.pool.invalidateItem(f.item)
+}
+
+// FetchHandle is like Fetch, but wraps the result in a Fetched handle whose
+// Return() preserves the resource's original allocation time.
+func (p *Pool[T]) FetchHandle() (Fetched[T], error) {
+	return p.FetchHandleContext(context.Background())
+}
+
+// FetchHandleContext is like FetchContext, but wraps the result in a
+// Fetched handle whose Return() preserves the resource's original
+// allocation time, so MaxLifetime is enforced across busy fetch/return
+// cycles rather than only while the resource sits idle.
+func (p *Pool[T]) FetchHandleContext(ctx context.Context) (Fetched[T], error) {
+	item, err := p.fetchItem(ctx)
+	if err != nil {
+		return Fetched[T]{}, err
+	}
+	return Fetched[T]{pool: p, item: item}, nil
+}
+
+// isClosed reports whether Close has been called, by checking whether
+// quitCommand has been closed. It is safe to call concurrently and any
+// number of times.
+func (p *Pool[T]) isClosed() bool {
+	select {
+	case <-p.quitCommand:
+		return true
+	default:
+		return false
+	}
+}
+
+// outstanding returns the number of resources that have been allocated but
+// are not currently sitting idle in the pool.
+func (p *Pool[T]) outstanding() int64 {
+	return atomic.LoadInt64(&p.liveCount) - int64(len(p.resources))
+}
+
+// Close stops the monitor goroutine and refuses further Fetch/FetchContext
+// calls, which will return ErrPoolClosed. It then waits for all resources
+// currently checked out to be returned via Return, or for ctx to be done,
+// whichever comes first, and finally deallocates every resource left idle
+// in the pool.
+func (p *Pool[T]) Close(ctx context.Context) error {
+	p.closeMu.Lock()
+	if p.closed {
+		p.closeMu.Unlock()
+		return nil
+	}
+	p.closed = true
+	close(p.quitCommand)
+	p.closeMu.Unlock()
+
+	ticker := time.NewTicker(20 * time.Millisecond)
+	defer ticker.Stop()
+
+	for p.outstanding() > 0 {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+
+	// Wait for the monitor goroutine to actually notice quitCommand and
+	// exit before draining. Without this, a replenish allocation the
+	// monitor already had in flight when Close was called can land in
+	// p.resources right after the drain below has already run, leaking it
+	// with nobody left to ever receive or deallocate it.
+	select {
+	case <-p.monitorDone:
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+
+	for {
+		select {
+		case item := <-p.resources:
+			p.discard(item)
+		default:
+			return nil
+		}
+	}
+}
+
+// Borrow reserves a slot against the pool's MaxInUse limit, blocking until
+// one is free. It does not fetch a resource itself; pair it with Fetch or
+// FetchContext to turn the pool into a hard concurrency limiter, and call
+// Release exactly once for every successful Borrow.
+func (p *Pool[T]) Borrow() {
+	p.inUseSem <- struct{}{}
+	atomic.AddInt64(&p.inUse, 1)
+}
+
+// TryBorrow attempts to reserve a slot against the pool's MaxInUse limit
+// without blocking. It returns false if the limit is already reached.
+func (p *Pool[T]) TryBorrow() bool {
+	select {
+	case p.inUseSem <- struct{}{}:
+		atomic.AddInt64(&p.inUse, 1)
+		return true
+	default:
+		return false
+	}
+}
+
+// Release frees a slot reserved by Borrow or TryBorrow. It returns
+// ErrNotBorrowed if called more times than Borrow/TryBorrow succeeded.
+func (p *Pool[T]) Release() error {
+	select {
+	case <-p.inUseSem:
+		atomic.AddInt64(&p.inUse, -1)
+		return nil
+	default:
+		return ErrNotBorrowed
+	}
+}
+
 // GetAvailable will return the current number of items available in the pool.
-func (p *Pool) GetAvailable() int {
+func (p *Pool[T]) GetAvailable() int {
 	return len(p.resources)
 }
+
+// Stats returns a snapshot of the pool's activity counters. It is safe to
+// call concurrently with any other Pool method.
+func (p *Pool[T]) Stats() Stats {
+	return Stats{
+		Idle:             len(p.resources),
+		InUse:            atomic.LoadInt64(&p.inUse),
+		TotalAllocated:   atomic.LoadInt64(&p.totalAllocated),
+		TotalDeallocated: atomic.LoadInt64(&p.totalDeallocated),
+		AllocErrors:      atomic.LoadInt64(&p.allocErrors),
+		WaitCount:        atomic.LoadInt64(&p.waitCount),
+		WaitDuration:     time.Duration(atomic.LoadInt64(&p.waitDuration)),
+		Timeouts:         atomic.LoadInt64(&p.timeouts),
+	}
+}
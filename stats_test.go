@@ -0,0 +1,46 @@
+package splash
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+func TestStatsCounters(t *testing.T) {
+	p := testPool(t, Minimum[int](1))
+	waitFor(t, time.Second, func() bool { return p.Stats().TotalAllocated == 1 })
+
+	got, err := p.FetchContext(context.Background())
+	if err != nil {
+		t.Fatalf("FetchContext: %v", err)
+	}
+
+	if s := p.Stats(); s.InUse != 1 {
+		t.Fatalf("Stats().InUse = %d, want 1 while a resource is fetched", s.InUse)
+	}
+	if s := p.Stats(); s.WaitCount == 0 {
+		t.Fatalf("Stats().WaitCount = 0, want at least 1 after a FetchContext call")
+	}
+
+	p.Return(got)
+
+	if s := p.Stats(); s.InUse != 0 {
+		t.Fatalf("Stats().InUse = %d, want 0 after Return", s.InUse)
+	}
+}
+
+func TestStatsTimeouts(t *testing.T) {
+	p := testPool(t)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	defer cancel()
+
+	if _, err := p.FetchContext(ctx); !errors.Is(err, ErrPoolExhausted) {
+		t.Fatalf("FetchContext on an empty pool: got %v, want ErrPoolExhausted", err)
+	}
+
+	if s := p.Stats(); s.Timeouts != 1 {
+		t.Fatalf("Stats().Timeouts = %d, want 1", s.Timeouts)
+	}
+}
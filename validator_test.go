@@ -0,0 +1,54 @@
+package splash
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestValidatorSkipsInvalidResource(t *testing.T) {
+	p := testPool(t, Validator[int](func(r int) bool { return r != 99 }))
+
+	p.Return(99)
+	p.Return(7)
+
+	got, err := p.FetchContext(context.Background())
+	if err != nil {
+		t.Fatalf("FetchContext: %v", err)
+	}
+	if got != 7 {
+		t.Fatalf("FetchContext returned %d, want 7 (99 should have failed the validator)", got)
+	}
+
+	waitFor(t, time.Second, func() bool {
+		return p.Stats().TotalDeallocated == 1
+	})
+}
+
+func TestMaxLifetimeExpiresOldResource(t *testing.T) {
+	p := testPool(t, MaxLifetime[int](20*time.Millisecond))
+
+	p.Return(1)
+	time.Sleep(40 * time.Millisecond)
+	p.Return(2)
+
+	got, err := p.FetchContext(context.Background())
+	if err != nil {
+		t.Fatalf("FetchContext: %v", err)
+	}
+	if got != 2 {
+		t.Fatalf("FetchContext returned %d, want 2 (1 should have exceeded MaxLifetime)", got)
+	}
+}
+
+func TestMaxIdleExpiresIdleResource(t *testing.T) {
+	p := testPool(t, MaxIdle[int](20*time.Millisecond))
+
+	p.Return(1)
+	waitFor(t, time.Second, func() bool {
+		return p.Stats().TotalDeallocated == 1
+	})
+	if p.GetAvailable() != 0 {
+		t.Fatalf("GetAvailable() = %d, want 0 after MaxIdle sweep", p.GetAvailable())
+	}
+}